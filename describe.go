@@ -0,0 +1,383 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+)
+
+// describeMetadata mirrors the Metadata section of `kubectl describe`.
+type describeMetadata struct {
+	Name              string            `json:"name" yaml:"name"`
+	Namespace         string            `json:"namespace" yaml:"namespace"`
+	Labels            map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Annotations       map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	CreationTimestamp string            `json:"creationTimestamp,omitempty" yaml:"creationTimestamp,omitempty"`
+	OwnerReferences   []string          `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+}
+
+type describeSpec struct {
+	Pod                   string `json:"pod,omitempty" yaml:"pod,omitempty"`
+	PodNamespace          string `json:"podNamespace,omitempty" yaml:"podNamespace,omitempty"`
+	Volume                string `json:"volume,omitempty" yaml:"volume,omitempty"`
+	Node                  string `json:"node,omitempty" yaml:"node,omitempty"`
+	RepoIdentifier        string `json:"repoIdentifier,omitempty" yaml:"repoIdentifier,omitempty"`
+	BackupStorageLocation string `json:"backupStorageLocation,omitempty" yaml:"backupStorageLocation,omitempty"`
+	UploaderType          string `json:"uploaderType,omitempty" yaml:"uploaderType,omitempty"`
+}
+
+type describeStatus struct {
+	Phase               string `json:"phase,omitempty" yaml:"phase,omitempty"`
+	StartTimestamp      string `json:"startTimestamp,omitempty" yaml:"startTimestamp,omitempty"`
+	CompletionTimestamp string `json:"completionTimestamp,omitempty" yaml:"completionTimestamp,omitempty"`
+	TotalBytes          *int64 `json:"totalBytes,omitempty" yaml:"totalBytes,omitempty"`
+	BytesDone           *int64 `json:"bytesDone,omitempty" yaml:"bytesDone,omitempty"`
+	SnapshotID          string `json:"snapshotID,omitempty" yaml:"snapshotID,omitempty"`
+	Message             string `json:"message,omitempty" yaml:"message,omitempty"`
+	Path                string `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
+type describeEvent struct {
+	Type           string `json:"type" yaml:"type"`
+	Reason         string `json:"reason" yaml:"reason"`
+	Message        string `json:"message" yaml:"message"`
+	Count          int32  `json:"count" yaml:"count"`
+	FirstTimestamp string `json:"firstTimestamp,omitempty" yaml:"firstTimestamp,omitempty"`
+	LastTimestamp  string `json:"lastTimestamp,omitempty" yaml:"lastTimestamp,omitempty"`
+}
+
+// describeReport is the full multi-section report for a single
+// PodVolumeBackup, machine-readable via -o yaml|json.
+type describeReport struct {
+	Metadata describeMetadata `json:"metadata" yaml:"metadata"`
+	Spec     describeSpec     `json:"spec" yaml:"spec"`
+	Status   describeStatus   `json:"status" yaml:"status"`
+	Events   []describeEvent  `json:"events,omitempty" yaml:"events,omitempty"`
+}
+
+// runDescribe implements `kubectl podvolumebackup-list describe`.
+func runDescribe(args []string) {
+	fs := pflag.NewFlagSet("describe", pflag.ExitOnError)
+	podFilterFlag := fs.String("pod", "", "Comma-separated list. Include items where pod name contains ANY of these substrings (case-insensitive)")
+	podNSFilterFlag := fs.String("pod-namespace", "", "Comma-separated list. Include items where pod namespace equals ANY of these (exact match)")
+	volumeFilterFlag := fs.String("volume", "", "Comma-separated list. Include items where volume equals ANY of these (exact match)")
+	backupFilterFlag := fs.String("backup", "", "Comma-separated list. Include items where the velero.io/backup-name label equals ANY of these (exact match)")
+	veleroNsFlag := fs.String("velero-namespace", "velero", "Namespace where PodVolumeBackup CRs are (default: velero)")
+	outputFlag := fs.StringP("output", "o", "pretty", "Output format: pretty|yaml|json")
+	colorFlag := fs.String("color", "auto", "Color mode for pretty output: auto|always|never")
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, `
+Usage:
+  kubectl podvolumebackup-list describe <name>|--pod=...|--backup=... [--velero-namespace=<ns>] [-o pretty|yaml|json] [--color=auto|always|never]
+
+Describes one or more PodVolumeBackups in the style of "kubectl describe",
+with Metadata, Spec, Status, and Events sections. Selecting by --pod,
+--pod-namespace, --volume or --backup may match more than one resource; each
+match renders its own report.
+`)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		os.Exit(1)
+	}
+
+	var exactName string
+	if fs.NArg() > 0 {
+		exactName = fs.Arg(0)
+	}
+	filters := filtersFromFlags(*podFilterFlag, *podNSFilterFlag, *volumeFilterFlag)
+	backupAllowed := splitCSV(*backupFilterFlag)
+
+	outMode := strings.ToLower(strings.TrimSpace(*outputFlag))
+	switch outMode {
+	case "pretty", "yaml", "json":
+	default:
+		fmt.Fprintf(os.Stderr, "Invalid --output: %s (allowed: pretty|yaml|json)\n", outMode)
+		os.Exit(1)
+	}
+	colorEnabled := detectColor(*colorFlag)
+
+	cfg, err := restConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	dyn, err := newDynamicClient(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	clientset, err := newClientset(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	pvbList, err := dyn.Resource(podVolumeBackupGVR).
+		Namespace(*veleroNsFlag).
+		List(ctx, metav1.ListOptions{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to list PodVolumeBackups: %v\n", err)
+		os.Exit(1)
+	}
+
+	var reports []describeReport
+	for _, item := range pvbList.Items {
+		if exactName != "" && item.GetName() != exactName {
+			continue
+		}
+
+		spec, _ := nestedSpec(item.Object)
+		if exactName == "" {
+			if !filters.matches(spec.Pod, spec.PodNamespace, spec.Volume) {
+				continue
+			}
+			backupName := item.GetLabels()["velero.io/backup-name"]
+			if len(backupAllowed) > 0 && !anyEqual(backupName, backupAllowed) {
+				continue
+			}
+		}
+
+		events, err := fetchEvents(ctx, clientset, item.GetNamespace(), string(item.GetUID()))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to fetch events for %s: %v\n", item.GetName(), err)
+		}
+
+		reports = append(reports, buildDescribeReport(item, spec, events))
+	}
+
+	if len(reports) == 0 {
+		fmt.Fprintln(os.Stderr, "No matching PodVolumeBackups found.")
+		os.Exit(1)
+	}
+
+	switch outMode {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(reports); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to encode JSON: %v\n", err)
+			os.Exit(1)
+		}
+	case "yaml":
+		b, err := yaml.Marshal(reports)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to encode YAML: %v\n", err)
+			os.Exit(1)
+		}
+		_, _ = os.Stdout.Write(b)
+	default:
+		for i, r := range reports {
+			printDescribeReport(r, colorEnabled)
+			if i < len(reports)-1 {
+				fmt.Println(prettyDivider(colorEnabled))
+			}
+		}
+	}
+}
+
+type pvbSpec struct {
+	Pod                   string
+	PodNamespace          string
+	Volume                string
+	Node                  string
+	RepoIdentifier        string
+	BackupStorageLocation string
+	UploaderType          string
+}
+
+// nestedSpec pulls the fields describe, --summary and serve care about out of
+// an unstructured PVB's spec.
+func nestedSpec(obj map[string]interface{}) (pvbSpec, bool) {
+	var s pvbSpec
+	spec, found, _ := unstructured.NestedMap(obj, "spec")
+	if !found {
+		return s, false
+	}
+	if pod, foundPod, _ := unstructured.NestedMap(spec, "pod"); foundPod {
+		if n, ok := pod["name"].(string); ok {
+			s.Pod = n
+		}
+		if n, ok := pod["namespace"].(string); ok {
+			s.PodNamespace = n
+		}
+	}
+	if v, ok := spec["volume"].(string); ok {
+		s.Volume = v
+	}
+	if v, ok := spec["node"].(string); ok {
+		s.Node = v
+	}
+	if v, ok := spec["repoIdentifier"].(string); ok {
+		s.RepoIdentifier = v
+	}
+	if v, ok := spec["backupStorageLocation"].(string); ok {
+		s.BackupStorageLocation = v
+	}
+	if v, ok := spec["uploaderType"].(string); ok {
+		s.UploaderType = v
+	}
+	return s, true
+}
+
+func buildDescribeReport(item unstructured.Unstructured, spec pvbSpec, events []describeEvent) describeReport {
+	var owners []string
+	for _, o := range item.GetOwnerReferences() {
+		owners = append(owners, fmt.Sprintf("%s/%s", o.Kind, o.Name))
+	}
+
+	status, _, _ := unstructured.NestedMap(item.Object, "status")
+	var st describeStatus
+	if status != nil {
+		st.Phase, _ = status["phase"].(string)
+		st.StartTimestamp, _ = status["startTimestamp"].(string)
+		st.CompletionTimestamp, _ = status["completionTimestamp"].(string)
+		st.SnapshotID, _ = status["snapshotID"].(string)
+		st.Message, _ = status["message"].(string)
+		st.Path, _ = status["path"].(string)
+		if progress, found, _ := unstructured.NestedMap(item.Object, "status", "progress"); found {
+			if v, ok := progress["totalBytes"]; ok {
+				if n, ok2 := getInt64(v); ok2 {
+					st.TotalBytes = &n
+				}
+			}
+			if v, ok := progress["bytesDone"]; ok {
+				if n, ok2 := getInt64(v); ok2 {
+					st.BytesDone = &n
+				}
+			}
+		}
+	}
+
+	return describeReport{
+		Metadata: describeMetadata{
+			Name:              item.GetName(),
+			Namespace:         item.GetNamespace(),
+			Labels:            item.GetLabels(),
+			Annotations:       item.GetAnnotations(),
+			CreationTimestamp: item.GetCreationTimestamp().Format(time.RFC3339),
+			OwnerReferences:   owners,
+		},
+		Spec: describeSpec{
+			Pod:                   spec.Pod,
+			PodNamespace:          spec.PodNamespace,
+			Volume:                spec.Volume,
+			Node:                  spec.Node,
+			RepoIdentifier:        spec.RepoIdentifier,
+			BackupStorageLocation: spec.BackupStorageLocation,
+			UploaderType:          spec.UploaderType,
+		},
+		Status: st,
+		Events: events,
+	}
+}
+
+// fetchEvents lists core Events involving the given object UID, newest last,
+// matching the ordering "kubectl describe" uses.
+func fetchEvents(ctx context.Context, clientset kubernetes.Interface, namespace, uid string) ([]describeEvent, error) {
+	list, err := clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.uid=" + uid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]describeEvent, 0, len(list.Items))
+	for _, e := range list.Items {
+		events = append(events, describeEvent{
+			Type:           e.Type,
+			Reason:         e.Reason,
+			Message:        e.Message,
+			Count:          e.Count,
+			FirstTimestamp: e.FirstTimestamp.Format(time.RFC3339),
+			LastTimestamp:  e.LastTimestamp.Format(time.RFC3339),
+		})
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].LastTimestamp < events[j].LastTimestamp
+	})
+	return events, nil
+}
+
+func printDescribeReport(r describeReport, colorEnabled bool) {
+	section := func(s string) string { return c(colorEnabled, "1;34", s) } // bold blue
+	lbl := func(s string) string { return c(colorEnabled, "36", s) }       // cyan
+	val := func(s string) string { return c(colorEnabled, "97", s) }       // bright white
+	sec := func(s string) string { return c(colorEnabled, "2", s) }        // dim
+
+	fmt.Printf("%s %s\n", section("Name:"), val(r.Metadata.Name))
+	fmt.Printf("%s %s\n", section("Namespace:"), val(r.Metadata.Namespace))
+
+	fmt.Println(section("Metadata:"))
+	fmt.Printf("  %s %s\n", lbl("Created:"), val(r.Metadata.CreationTimestamp))
+	if len(r.Metadata.Labels) > 0 {
+		fmt.Printf("  %s\n", lbl("Labels:"))
+		for _, k := range sortedKeys(r.Metadata.Labels) {
+			fmt.Printf("    %s=%s\n", k, r.Metadata.Labels[k])
+		}
+	}
+	if len(r.Metadata.Annotations) > 0 {
+		fmt.Printf("  %s\n", lbl("Annotations:"))
+		for _, k := range sortedKeys(r.Metadata.Annotations) {
+			fmt.Printf("    %s=%s\n", k, r.Metadata.Annotations[k])
+		}
+	}
+	if len(r.Metadata.OwnerReferences) > 0 {
+		fmt.Printf("  %s %s\n", lbl("Owners:"), val(strings.Join(r.Metadata.OwnerReferences, ", ")))
+	}
+
+	fmt.Println(section("Spec:"))
+	fmt.Printf("  %s %s\n", lbl("Pod:"), val(r.Spec.Pod))
+	fmt.Printf("  %s %s\n", lbl("Pod namespace:"), val(r.Spec.PodNamespace))
+	fmt.Printf("  %s %s\n", lbl("Volume:"), val(r.Spec.Volume))
+	fmt.Printf("  %s %s\n", lbl("Node:"), val(r.Spec.Node))
+	fmt.Printf("  %s %s\n", lbl("Repo identifier:"), val(r.Spec.RepoIdentifier))
+	fmt.Printf("  %s %s\n", lbl("Backup storage location:"), val(r.Spec.BackupStorageLocation))
+	fmt.Printf("  %s %s\n", lbl("Uploader type:"), val(r.Spec.UploaderType))
+
+	fmt.Println(section("Status:"))
+	fmt.Printf("  %s %s\n", lbl("Phase:"), val(r.Status.Phase))
+	fmt.Printf("  %s %s\n", lbl("Started:"), val(r.Status.StartTimestamp))
+	fmt.Printf("  %s %s\n", lbl("Completed:"), val(r.Status.CompletionTimestamp))
+	if r.Status.TotalBytes != nil {
+		fmt.Printf("  %s %s\n", lbl("Total bytes:"), val(fmt.Sprintf("%d (%s)", *r.Status.TotalBytes, humanBytes(*r.Status.TotalBytes))))
+	}
+	if r.Status.BytesDone != nil {
+		fmt.Printf("  %s %s\n", lbl("Bytes done:"), val(fmt.Sprintf("%d (%s)", *r.Status.BytesDone, humanBytes(*r.Status.BytesDone))))
+	}
+	fmt.Printf("  %s %s\n", lbl("Snapshot ID:"), val(r.Status.SnapshotID))
+	fmt.Printf("  %s %s\n", lbl("Path:"), val(r.Status.Path))
+	if r.Status.Message != "" {
+		fmt.Printf("  %s %s\n", lbl("Message:"), val(r.Status.Message))
+	}
+
+	fmt.Println(section("Events:"))
+	if len(r.Events) == 0 {
+		fmt.Printf("  %s\n", sec("<none>"))
+	} else {
+		for _, e := range r.Events {
+			fmt.Printf("  %s %s %s %s\n", sec(e.LastTimestamp), lbl(e.Type), lbl(e.Reason), val(e.Message))
+		}
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
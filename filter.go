@@ -0,0 +1,32 @@
+package main
+
+// pvbFilters holds the parsed --pod/--pod-namespace/--volume filter values
+// shared by every subcommand that lists PodVolumeBackups.
+type pvbFilters struct {
+	podNeedles    []string // substring, case-insensitive
+	nsAllowed     []string // exact match
+	volumeAllowed []string // exact match
+}
+
+func filtersFromFlags(pod, podNamespace, volume string) pvbFilters {
+	return pvbFilters{
+		podNeedles:    splitCSV(pod),
+		nsAllowed:     splitCSV(podNamespace),
+		volumeAllowed: splitCSV(volume),
+	}
+}
+
+// matches reports whether a PVB's pod name/namespace/volume pass all
+// configured filters.
+func (f pvbFilters) matches(podName, podNamespace, volume string) bool {
+	if len(f.podNeedles) > 0 && (podName == "" || !anyContainsFold(podName, f.podNeedles)) {
+		return false
+	}
+	if len(f.nsAllowed) > 0 && !anyEqual(podNamespace, f.nsAllowed) {
+		return false
+	}
+	if len(f.volumeAllowed) > 0 && !anyEqual(volume, f.volumeAllowed) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Digitalist-Open-Cloud/krew-podvolumebackup-list/internal/formats"
+)
+
+// renderTemplate implements --format/--template-file: a podman-style
+// "give me any column I want" escape hatch from the fixed table/pretty/csv
+// renderers. A leading "table " prefix tab-aligns the rendered rows; a bare
+// "json" is a shorthand for -o json.
+func renderTemplate(w io.Writer, rows []Row, format, templateFile string) error {
+	tmplText := format
+	if templateFile != "" {
+		b, err := os.ReadFile(templateFile)
+		if err != nil {
+			return fmt.Errorf("failed to read --template-file: %w", err)
+		}
+		tmplText = string(b)
+	}
+
+	if tmplText == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	}
+
+	tabular := strings.HasPrefix(tmplText, "table ")
+	if tabular {
+		tmplText = strings.TrimPrefix(tmplText, "table ")
+	}
+
+	f, err := formats.New("format", tmplText, Row{})
+	if err != nil {
+		return err
+	}
+
+	if !tabular {
+		return f.Execute(w, rows)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	if err := f.Execute(tw, rows); err != nil {
+		return err
+	}
+	return tw.Flush()
+}
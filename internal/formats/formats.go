@@ -0,0 +1,121 @@
+// Package formats implements the --format Go template output mode, in the
+// same spirit as podman's cmd/podman/formats package: wrap text/template
+// with a handful of CLI-friendly helpers and validate the template eagerly
+// so typos surface before any output is printed.
+package formats
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Formatter renders values through a validated text/template.
+type Formatter struct {
+	tmpl *template.Template
+}
+
+// New parses tmplText as a named template and validates it by executing it
+// once against sample (typically the zero value of the row type being
+// formatted). Executing eagerly, rather than only at parse time, is what
+// catches references to fields that don't exist on the row type.
+func New(name, tmplText string, sample interface{}) (*Formatter, error) {
+	tmpl, err := template.New(name).Funcs(FuncMap()).Parse(tmplText)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	if err := tmpl.Execute(io.Discard, sample); err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return &Formatter{tmpl: tmpl}, nil
+}
+
+// Execute renders items (a slice or a single value) to w, one line per
+// element when items is a slice.
+func (f *Formatter) Execute(w io.Writer, items interface{}) error {
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice {
+		return f.tmpl.Execute(w, items)
+	}
+	for i := 0; i < v.Len(); i++ {
+		if err := f.tmpl.Execute(w, v.Index(i).Interface()); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, "\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FuncMap is the helper set available to every --format template.
+func FuncMap() map[string]interface{} {
+	return map[string]interface{}{
+		"humanBytes": humanBytes,
+		"rfc3339":    rfc3339,
+		"truncate":   truncate,
+		"json":       toJSON,
+		"yaml":       toYAML,
+		"upper":      strings.ToUpper,
+		"lower":      strings.ToLower,
+	}
+}
+
+func humanBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
+
+// rfc3339 accepts a time.Time or an already-formatted timestamp string and
+// returns it as RFC3339, leaving unparsable strings untouched.
+func rfc3339(v interface{}) string {
+	switch t := v.(type) {
+	case time.Time:
+		return t.Format(time.RFC3339)
+	case string:
+		if parsed, err := time.Parse(time.RFC3339, t); err == nil {
+			return parsed.Format(time.RFC3339)
+		}
+		return t
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
+
+func toJSON(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func toYAML(v interface{}) (string, error) {
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(b), "\n"), nil
+}
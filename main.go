@@ -6,24 +6,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
-	"time"
 
 	"github.com/spf13/pflag"
 	"golang.org/x/term"
 	"sigs.k8s.io/yaml"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/client-go/dynamic"
-	_ "k8s.io/client-go/plugin/pkg/client/auth"
-	"k8s.io/client-go/tools/clientcmd"
-	"k8s.io/client-go/util/homedir"
 )
 
 // Velero PodVolumeBackup GVR
@@ -33,7 +26,7 @@ var podVolumeBackupGVR = schema.GroupVersionResource{
 	Resource: "podvolumebackups",
 }
 
-type row struct {
+type Row struct {
 	PodName        string `json:"podName" yaml:"podName"`
 	PodNamespace   string `json:"podNamespace" yaml:"podNamespace"`
 	Volume         string `json:"volume" yaml:"volume"`
@@ -147,6 +140,19 @@ func prettyDivider(enabled bool) string {
 }
 
 func main() {
+	// Subkommandon (t.ex. "describe") grenas av innan pflag.Parse, eftersom
+	// de har sin egen flaggmängd.
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "describe":
+			runDescribe(os.Args[2:])
+			return
+		case "serve":
+			runServe(os.Args[2:])
+			return
+		}
+	}
+
 	// Flaggor
 	podFilterFlag := pflag.String("pod", "", "Comma-separated list. Include items where pod name contains ANY of these substrings (case-insensitive)")
 	podNSFilterFlag := pflag.String("pod-namespace", "", "Comma-separated list. Include items where pod namespace equals ANY of these (exact match)")
@@ -154,22 +160,31 @@ func main() {
 	allFlag := pflag.Bool("all", false, "List all podvolumebackups instead of filtering by backup name prefix")
 	veleroNsFlag := pflag.String("velero-namespace", "velero", "Namespace where PodVolumeBackup CRs are (default: velero)")
 	outputFlag := pflag.StringP("output", "o", "table", "Output format: table|json|yaml|csv|pretty")
+	formatFlag := pflag.String("format", "", `Go template output, e.g. --format "{{.PodName}} {{.SizeHuman}}" (overrides --output; prefix with "table " to tab-align the rendered rows)`)
+	templateFileFlag := pflag.String("template-file", "", "Read the --format template from a file instead of the command line")
 	colorFlag := pflag.String("color", "auto", "Color mode for pretty output: auto|always|never")
 	debugFlag := pflag.Bool("debug", false, "Print debug info to stderr")
+	watchFlag := pflag.BoolP("watch", "w", false, "Watch for PodVolumeBackup changes and stream ADDED/MODIFIED/DELETED rows instead of listing once")
+	summaryFlag := pflag.Bool("summary", false, "Aggregate matching rows into count/size totals grouped by --group-by instead of listing individual rows")
+	groupByFlag := pflag.String("group-by", "backup", "Comma-separated grouping keys for --summary: backup|pod-namespace|pod|volume|node|phase")
 
 	pflag.Usage = func() {
 		fmt.Fprintf(os.Stderr, `
 Usage:
-  kubectl podvolumebackup-list [prefix|--all] [--velero-namespace=<ns>] [--pod=a,b] [--pod-namespace=x,y] [--volume=v1,v2] [-o table|json|yaml|csv|pretty] [--color=auto|always|never] [--debug]
+  kubectl podvolumebackup-list [prefix|--all] [--velero-namespace=<ns>] [--pod=a,b] [--pod-namespace=x,y] [--volume=v1,v2] [-o table|json|yaml|csv|pretty] [--format=<template>] [--template-file=<path>] [-w|--watch] [--summary [--group-by=<keys>]] [--color=auto|always|never] [--debug]
+  kubectl podvolumebackup-list describe <name>|--pod=...|--backup=... [--velero-namespace=<ns>] [-o pretty|yaml|json] [--color=auto|always|never]
+  kubectl podvolumebackup-list serve --listen=:9102 [--velero-namespace=<ns>] [--pod=a,b] [--pod-namespace=x,y] [--volume=v1,v2] [--label-selector=<sel>] [--field-selector=<sel>]
 
 Notes:
   --pod            substring match (case-insensitive), ANY of comma-separated values
   --pod-namespace  exact match, ANY of comma-separated values
   --volume         exact match, ANY of comma-separated values
+  --format         Go template applied per row; available helpers: humanBytes, rfc3339, truncate, json, yaml, upper, lower
 
 Examples:
   kubectl podvolumebackup-list --all --pod=nginx,redis --pod-namespace=dev,prod --volume=data,cache -o pretty
   kubectl podvolumebackup-list nightly- --pod=nginx --pod-namespace=prod --volume=myvol --velero-namespace=velero -o json
+  kubectl podvolumebackup-list --all --format "{{.PodName}}	{{.SizeHuman}}"
 `)
 		pflag.PrintDefaults()
 	}
@@ -186,36 +201,19 @@ Examples:
 	}
 
 	// Kubeconfig
-	var kubeconfig string
-	if env := os.Getenv("KUBECONFIG"); env != "" {
-		kubeconfig = env
-	} else if home := homedir.HomeDir(); home != "" {
-		kubeconfig = filepath.Join(home, ".kube", "config")
-	}
-	cfg, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to get kubeconfig: %v\n", err)
-		os.Exit(1)
-	}
-	client, err := dynamic.NewForConfig(cfg)
+	cfg, err := restConfig()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to create dynamic client: %v\n", err)
+		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
-
-	// Hämta resurser
-	pvbList, err := client.Resource(podVolumeBackupGVR).
-		Namespace(*veleroNsFlag).
-		List(context.Background(), metav1.ListOptions{})
+	client, err := newDynamicClient(cfg)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to list PodVolumeBackups: %v\n", err)
+		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
 
 	// Bygg filterlistor
-	podNeedles := splitCSV(*podFilterFlag)       // substring, CI
-	nsAllowed := splitCSV(*podNSFilterFlag)      // exact
-	volumeAllowed := splitCSV(*volumeFilterFlag) // exact
+	filters := filtersFromFlags(*podFilterFlag, *podNSFilterFlag, *volumeFilterFlag)
 
 	// Validera output
 	outMode := strings.ToLower(strings.TrimSpace(*outputFlag))
@@ -227,94 +225,45 @@ Examples:
 	}
 	colorEnabled := detectColor(*colorFlag)
 
+	if *watchFlag {
+		runWatch(client, *veleroNsFlag, filters, *allFlag, prefix, outMode, colorEnabled, *debugFlag)
+		return
+	}
+
+	// Hämta resurser
+	pvbList, err := client.Resource(podVolumeBackupGVR).
+		Namespace(*veleroNsFlag).
+		List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to list PodVolumeBackups: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *summaryFlag {
+		groupBy := splitCSV(*groupByFlag)
+		if len(groupBy) == 0 {
+			groupBy = []string{"backup"}
+		}
+		if err := runSummary(pvbList.Items, *allFlag, prefix, filters, groupBy, outMode, *debugFlag); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Samla resultat
-	rows := make([]row, 0, len(pvbList.Items))
+	rows := make([]Row, 0, len(pvbList.Items))
 	for _, item := range pvbList.Items {
 		name := item.GetName()
 		if !*allFlag && !strings.HasPrefix(name, prefix) {
 			continue
 		}
 
-		var podName, podNS, volume string
-		var size *int64
-		var sizeHuman string
-		var createdHuman string
-		var createdRFC string
-		labels := item.GetLabels()
-		backupName := ""
-		if labels != nil {
-			backupName = labels["velero.io/backup-name"]
-		}
-
-		// Spec
-		if spec, found, _ := unstructured.NestedMap(item.Object, "spec"); found {
-			if pod, foundPod, _ := unstructured.NestedMap(spec, "pod"); foundPod {
-				if n, ok := pod["name"].(string); ok {
-					podName = n
-				}
-				if n, ok := pod["namespace"].(string); ok {
-					podNS = n
-				}
-			}
-			if v, ok := spec["volume"].(string); ok {
-				volume = v
-			}
-		}
-
-		// Filtrera
-		if len(podNeedles) > 0 && (podName == "" || !anyContainsFold(podName, podNeedles)) {
-			continue
-		}
-		if len(nsAllowed) > 0 && !anyEqual(podNS, nsAllowed) {
-			continue
-		}
-		if len(volumeAllowed) > 0 && !anyEqual(volume, volumeAllowed) {
+		row := rowFromUnstructured(item, *debugFlag)
+		if !filters.matches(row.PodName, row.PodNamespace, row.Volume) {
 			continue
 		}
-
-		// Storlek: totalBytes, fallback bytesDone
-		if status, found, _ := unstructured.NestedMap(item.Object, "status"); found {
-			if progress, foundProgress, _ := unstructured.NestedMap(status, "progress"); foundProgress {
-				if *debugFlag {
-					fmt.Fprintf(os.Stderr, "DEBUG: name=%s, progress=%v\n", item.GetName(), progress)
-				}
-				if v, ok := progress["totalBytes"]; ok {
-					if n, ok2 := getInt64(v); ok2 {
-						val := n
-						size = &val
-						sizeHuman = humanBytes(*size)
-					}
-				} else if v, ok := progress["bytesDone"]; ok {
-					if n, ok2 := getInt64(v); ok2 {
-						val := n
-						size = &val
-						sizeHuman = humanBytes(*size)
-					}
-				}
-			}
-		}
-
-		// Created
-		if t, found, _ := unstructured.NestedString(item.Object, "metadata", "creationTimestamp"); found {
-			createdRFC = t
-			if ti, err := time.Parse(time.RFC3339, t); err == nil {
-				createdHuman = ti.Format("2006-01-02 15:04:05")
-			} else {
-				createdHuman = t
-			}
-		}
-
-		rows = append(rows, row{
-			PodName:        podName,
-			PodNamespace:   podNS,
-			Volume:         volume,
-			SizeBytes:      size,
-			SizeHuman:      sizeHuman,
-			Created:        createdHuman,
-			CreatedRFC3339: createdRFC,
-			BackupName:     backupName,
-			ResourceName:   name,
-		})
+		rows = append(rows, row)
 	}
 
 	// Sortera: podName, sedan volume
@@ -325,6 +274,15 @@ Examples:
 		return rows[i].PodName < rows[j].PodName
 	})
 
+	// --format/--template-file tar över -o när de används
+	if *formatFlag != "" || *templateFileFlag != "" {
+		if err := renderTemplate(os.Stdout, rows, *formatFlag, *templateFileFlag); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Skriv ut
 	switch outMode {
 	case "json":
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// rowFromUnstructured builds a Row from a raw PodVolumeBackup object. It is
+// shared by the one-shot list, --watch, --summary and serve/metrics modes so
+// they all agree on how fields are pulled out of the unstructured spec/status.
+func rowFromUnstructured(item unstructured.Unstructured, debug bool) Row {
+	var podName, podNS, volume string
+	var size *int64
+	var sizeHuman string
+	var createdHuman string
+	var createdRFC string
+	labels := item.GetLabels()
+	backupName := ""
+	if labels != nil {
+		backupName = labels["velero.io/backup-name"]
+	}
+
+	// Spec
+	if spec, found, _ := unstructured.NestedMap(item.Object, "spec"); found {
+		if pod, foundPod, _ := unstructured.NestedMap(spec, "pod"); foundPod {
+			if n, ok := pod["name"].(string); ok {
+				podName = n
+			}
+			if n, ok := pod["namespace"].(string); ok {
+				podNS = n
+			}
+		}
+		if v, ok := spec["volume"].(string); ok {
+			volume = v
+		}
+	}
+
+	// Storlek: totalBytes, fallback bytesDone
+	if status, found, _ := unstructured.NestedMap(item.Object, "status"); found {
+		if progress, foundProgress, _ := unstructured.NestedMap(status, "progress"); foundProgress {
+			if debug {
+				fmt.Fprintf(os.Stderr, "DEBUG: name=%s, progress=%v\n", item.GetName(), progress)
+			}
+			if v, ok := progress["totalBytes"]; ok {
+				if n, ok2 := getInt64(v); ok2 {
+					val := n
+					size = &val
+					sizeHuman = humanBytes(*size)
+				}
+			} else if v, ok := progress["bytesDone"]; ok {
+				if n, ok2 := getInt64(v); ok2 {
+					val := n
+					size = &val
+					sizeHuman = humanBytes(*size)
+				}
+			}
+		}
+	}
+
+	// Created
+	if t, found, _ := unstructured.NestedString(item.Object, "metadata", "creationTimestamp"); found {
+		createdRFC = t
+		if ti, err := time.Parse(time.RFC3339, t); err == nil {
+			createdHuman = ti.Format("2006-01-02 15:04:05")
+		} else {
+			createdHuman = t
+		}
+	}
+
+	return Row{
+		PodName:        podName,
+		PodNamespace:   podNS,
+		Volume:         volume,
+		SizeBytes:      size,
+		SizeHuman:      sizeHuman,
+		Created:        createdHuman,
+		CreatedRFC3339: createdRFC,
+		BackupName:     backupName,
+		ResourceName:   item.GetName(),
+	}
+}
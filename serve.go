@@ -0,0 +1,276 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/pflag"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+// buildVersion is overridden via -ldflags "-X main.buildVersion=..." at
+// release build time, the same convention most Go CLIs use for build_info.
+var buildVersion = "dev"
+
+// metricLabelNames is the label set attached to every per-PVB gauge.
+var metricLabelNames = []string{"backup", "pod", "pod_namespace", "volume", "phase"}
+
+type metricsStore struct {
+	bytes    *prometheus.GaugeVec
+	duration *prometheus.GaugeVec
+	progress *prometheus.GaugeVec
+	info     *prometheus.GaugeVec
+
+	mu     sync.Mutex
+	labels map[string]labelSet // resource name -> labels last used, for cleanup on delete
+}
+
+// labelSet is everything upsert needs to remember per resource so delete can
+// remove exactly the series it previously set.
+type labelSet struct {
+	core prometheus.Labels
+	info prometheus.Labels
+}
+
+func newMetricsStore(reg prometheus.Registerer) *metricsStore {
+	s := &metricsStore{
+		bytes: promauto.With(reg).NewGaugeVec(prometheus.GaugeOpts{
+			Name: "velero_podvolumebackup_bytes",
+			Help: "Bytes backed up (totalBytes, falling back to bytesDone) for a PodVolumeBackup.",
+		}, metricLabelNames),
+		duration: promauto.With(reg).NewGaugeVec(prometheus.GaugeOpts{
+			Name: "velero_podvolumebackup_duration_seconds",
+			Help: "Seconds between startTimestamp and completionTimestamp for a PodVolumeBackup.",
+		}, metricLabelNames),
+		progress: promauto.With(reg).NewGaugeVec(prometheus.GaugeOpts{
+			Name: "velero_podvolumebackup_progress_ratio",
+			Help: "bytesDone/totalBytes for a PodVolumeBackup, in [0,1].",
+		}, metricLabelNames),
+		info: promauto.With(reg).NewGaugeVec(prometheus.GaugeOpts{
+			Name: "velero_podvolumebackup_info",
+			Help: "Always 1; carries identifying labels for a PodVolumeBackup.",
+		}, append(append([]string{}, metricLabelNames...), "name", "node", "uploader_type")),
+		labels: map[string]labelSet{},
+	}
+	promauto.With(reg).NewGaugeFunc(prometheus.GaugeOpts{
+		Name:        "velero_podvolumebackup_list_build_info",
+		Help:        "Always 1; carries the plugin build version.",
+		ConstLabels: prometheus.Labels{"version": buildVersion},
+	}, func() float64 { return 1 })
+	return s
+}
+
+func (s *metricsStore) upsert(item unstructured.Unstructured, debug bool) {
+	row := rowFromUnstructured(item, debug)
+	spec, _ := nestedSpec(item.Object)
+	status, _, _ := unstructured.NestedMap(item.Object, "status")
+	phase, _ := status["phase"].(string)
+
+	labels := prometheus.Labels{
+		"backup":        row.BackupName,
+		"pod":           row.PodName,
+		"pod_namespace": row.PodNamespace,
+		"volume":        row.Volume,
+		"phase":         phase,
+	}
+
+	if row.SizeBytes != nil {
+		s.bytes.With(labels).Set(float64(*row.SizeBytes))
+	}
+
+	if start, ok := status["startTimestamp"].(string); ok {
+		if complete, ok := status["completionTimestamp"].(string); ok {
+			st, errStart := time.Parse(time.RFC3339, start)
+			ct, errComplete := time.Parse(time.RFC3339, complete)
+			if errStart == nil && errComplete == nil {
+				s.duration.With(labels).Set(ct.Sub(st).Seconds())
+			}
+		}
+	}
+
+	if progress, found, _ := unstructured.NestedMap(item.Object, "status", "progress"); found {
+		total, okTotal := getInt64(progress["totalBytes"])
+		done, okDone := getInt64(progress["bytesDone"])
+		if okTotal && okDone && total > 0 {
+			s.progress.With(labels).Set(float64(done) / float64(total))
+		}
+	}
+
+	infoLabels := prometheus.Labels{
+		"backup":        labels["backup"],
+		"pod":           labels["pod"],
+		"pod_namespace": labels["pod_namespace"],
+		"volume":        labels["volume"],
+		"phase":         labels["phase"],
+		"name":          item.GetName(),
+		"node":          spec.Node,
+		"uploader_type": spec.UploaderType,
+	}
+	s.info.With(infoLabels).Set(1)
+
+	s.mu.Lock()
+	if old, ok := s.labels[item.GetName()]; ok {
+		if !labelsEqual(old.core, labels) {
+			s.bytes.Delete(old.core)
+			s.duration.Delete(old.core)
+			s.progress.Delete(old.core)
+		}
+		if !labelsEqual(old.info, infoLabels) {
+			s.info.Delete(old.info)
+		}
+	}
+	s.labels[item.GetName()] = labelSet{core: labels, info: infoLabels}
+	s.mu.Unlock()
+}
+
+func (s *metricsStore) delete(resourceName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	set, ok := s.labels[resourceName]
+	if !ok {
+		return
+	}
+	s.bytes.Delete(set.core)
+	s.duration.Delete(set.core)
+	s.progress.Delete(set.core)
+	s.info.Delete(set.info)
+	delete(s.labels, resourceName)
+}
+
+func labelsEqual(a, b prometheus.Labels) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// runServe implements `kubectl podvolumebackup-list serve`: a long-running
+// Prometheus exporter backed by the same dynamic informer --watch uses, so
+// metrics update in near-real-time instead of per scrape.
+func runServe(args []string) {
+	fs := pflag.NewFlagSet("serve", pflag.ExitOnError)
+	listenFlag := fs.String("listen", ":9102", "Address to serve /metrics, /healthz and /readyz on")
+	veleroNsFlag := fs.String("velero-namespace", "velero", "Namespace where PodVolumeBackup CRs are (default: velero)")
+	podFilterFlag := fs.String("pod", "", "Comma-separated list. Only export metrics for pods whose name contains ANY of these substrings (case-insensitive)")
+	podNSFilterFlag := fs.String("pod-namespace", "", "Comma-separated list. Only export metrics for ANY of these pod namespaces (exact match)")
+	volumeFilterFlag := fs.String("volume", "", "Comma-separated list. Only export metrics for ANY of these volumes (exact match)")
+	labelSelectorFlag := fs.String("label-selector", "", "Label selector passed through to the PodVolumeBackup list/watch, as a cardinality control")
+	fieldSelectorFlag := fs.String("field-selector", "", "Field selector passed through to the PodVolumeBackup list/watch, as a cardinality control")
+	debugFlag := fs.Bool("debug", false, "Print debug info to stderr")
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, `
+Usage:
+  kubectl podvolumebackup-list serve --listen=:9102 [--velero-namespace=<ns>] [--pod=a,b] [--pod-namespace=x,y] [--volume=v1,v2] [--label-selector=<sel>] [--field-selector=<sel>]
+
+Starts a long-running Prometheus exporter on --listen, serving /metrics,
+/healthz and /readyz, until the process receives SIGINT/SIGTERM.
+`)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		os.Exit(1)
+	}
+
+	filters := filtersFromFlags(*podFilterFlag, *podNSFilterFlag, *volumeFilterFlag)
+
+	cfg, err := restConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	client, err := newDynamicClient(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	reg := prometheus.NewRegistry()
+	store := newMetricsStore(reg)
+
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(client, 0, *veleroNsFlag,
+		func(opts *metav1.ListOptions) {
+			opts.LabelSelector = *labelSelectorFlag
+			opts.FieldSelector = *fieldSelectorFlag
+		},
+	)
+	informer := factory.ForResource(podVolumeBackupGVR).Informer()
+
+	onUpsert := func(obj interface{}) {
+		item, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return
+		}
+		spec, _ := nestedSpec(item.Object)
+		if !filters.matches(spec.Pod, spec.PodNamespace, spec.Volume) {
+			return
+		}
+		store.upsert(*item, *debugFlag)
+	}
+	_, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    onUpsert,
+		UpdateFunc: func(_, newObj interface{}) { onUpsert(newObj) },
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if item, ok := obj.(*unstructured.Unstructured); ok {
+				store.delete(item.GetName())
+			}
+		},
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to register informer handler: %v\n", err)
+		os.Exit(1)
+	}
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	factory.Start(stopCh)
+	synced := factory.WaitForCacheSync(stopCh)
+	ready := func() bool {
+		for _, ok := range synced {
+			if !ok {
+				return false
+			}
+		}
+		return true
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		if !ready() {
+			http.Error(w, "informer cache not yet synced", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	server := &http.Server{Addr: *listenFlag, Handler: mux}
+	fmt.Fprintf(os.Stderr, "Serving metrics on %s/metrics (version=%s)\n", *listenFlag, buildVersion)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		fmt.Fprintf(os.Stderr, "Server error: %v\n", err)
+		os.Exit(1)
+	}
+}
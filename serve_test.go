@@ -0,0 +1,70 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func pvbMetricObject(name, backupName, podName, podNS, volume, node, uploaderType string, totalBytes int64) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   name,
+			"labels": map[string]interface{}{"velero.io/backup-name": backupName},
+		},
+		"spec": map[string]interface{}{
+			"pod":          map[string]interface{}{"name": podName, "namespace": podNS},
+			"volume":       volume,
+			"node":         node,
+			"uploaderType": uploaderType,
+		},
+		"status": map[string]interface{}{
+			"phase":    "Completed",
+			"progress": map[string]interface{}{"totalBytes": totalBytes, "bytesDone": totalBytes},
+		},
+	}}
+}
+
+func TestMetricsStoreUpsertSetsAndDeleteRemovesSeries(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	store := newMetricsStore(reg)
+
+	item := pvbMetricObject("pvb-1", "backup-a", "pod-1", "ns1", "vol1", "node-1", "restic", 100)
+	store.upsert(item, false)
+
+	if got := testutil.ToFloat64(store.bytes.With(prometheus.Labels{
+		"backup": "backup-a", "pod": "pod-1", "pod_namespace": "ns1", "volume": "vol1", "phase": "Completed",
+	})); got != 100 {
+		t.Errorf("expected bytes gauge of 100, got %v", got)
+	}
+	if n := testutil.CollectAndCount(store.info); n != 1 {
+		t.Errorf("expected 1 info series after upsert, got %d", n)
+	}
+
+	store.delete("pvb-1")
+
+	if n := testutil.CollectAndCount(store.bytes); n != 0 {
+		t.Errorf("expected 0 bytes series after delete, got %d", n)
+	}
+	if n := testutil.CollectAndCount(store.info); n != 0 {
+		t.Errorf("expected 0 info series after delete, got %d", n)
+	}
+}
+
+func TestMetricsStoreUpsertRemovesStaleInfoSeriesOnNodeChange(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	store := newMetricsStore(reg)
+
+	store.upsert(pvbMetricObject("pvb-1", "backup-a", "pod-1", "ns1", "vol1", "node-1", "restic", 100), false)
+	store.upsert(pvbMetricObject("pvb-1", "backup-a", "pod-1", "ns1", "vol1", "node-2", "restic", 100), false)
+
+	if n := testutil.CollectAndCount(store.info); n != 1 {
+		t.Errorf("expected exactly 1 info series once the node label changes, got %d (stale series leaked)", n)
+	}
+	if n := testutil.CollectAndCount(store.bytes); n != 1 {
+		t.Errorf("expected exactly 1 bytes series when core labels are unchanged, got %d", n)
+	}
+}
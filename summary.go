@@ -0,0 +1,179 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// summaryRow extends Row with the extra fields --group-by can key on, which
+// the flat list view doesn't otherwise need.
+type summaryRow struct {
+	Row
+	Node  string
+	Phase string
+}
+
+func buildSummaryRow(item unstructured.Unstructured, debug bool) summaryRow {
+	sr := summaryRow{Row: rowFromUnstructured(item, debug)}
+	if spec, ok := nestedSpec(item.Object); ok {
+		sr.Node = spec.Node
+	}
+	if status, found, _ := unstructured.NestedMap(item.Object, "status"); found {
+		sr.Phase, _ = status["phase"].(string)
+	}
+	return sr
+}
+
+var summaryGroupFields = map[string]func(summaryRow) string{
+	"backup":        func(r summaryRow) string { return r.BackupName },
+	"pod-namespace": func(r summaryRow) string { return r.PodNamespace },
+	"pod":           func(r summaryRow) string { return r.PodName },
+	"volume":        func(r summaryRow) string { return r.Volume },
+	"node":          func(r summaryRow) string { return r.Node },
+	"phase":         func(r summaryRow) string { return r.Phase },
+}
+
+// summaryAggregate is one grouped total, keyed by the --group-by values in
+// order.
+type summaryAggregate struct {
+	Key        []string `json:"key" yaml:"key"`
+	Count      int      `json:"count" yaml:"count"`
+	TotalBytes int64    `json:"totalBytes" yaml:"totalBytes"`
+	TotalHuman string   `json:"totalHuman" yaml:"totalHuman"`
+}
+
+// runSummary implements --summary: filter, group by the requested keys, and
+// render totals (plus a grand total) in the same -o table|json|yaml|csv|pretty
+// output modes the flat list supports.
+func runSummary(items []unstructured.Unstructured, all bool, prefix string, filters pvbFilters, groupBy []string, outMode string, debug bool) error {
+	aggregates, grandCount, grandBytes, err := aggregateSummary(items, all, prefix, filters, groupBy, debug)
+	if err != nil {
+		return err
+	}
+	return writeSummary(os.Stdout, groupBy, aggregates, grandCount, grandBytes, outMode)
+}
+
+// aggregateSummary does the filtering/grouping/totalling behind --summary,
+// split out from runSummary so it can be tested without going through stdout.
+func aggregateSummary(items []unstructured.Unstructured, all bool, prefix string, filters pvbFilters, groupBy []string, debug bool) ([]summaryAggregate, int, int64, error) {
+	for _, key := range groupBy {
+		if _, ok := summaryGroupFields[key]; !ok {
+			return nil, 0, 0, fmt.Errorf("invalid --group-by key %q (allowed: backup|pod-namespace|pod|volume|node|phase)", key)
+		}
+	}
+
+	order := make([]string, 0)
+	byKey := make(map[string]*summaryAggregate)
+	var grandCount int
+	var grandBytes int64
+
+	for _, item := range items {
+		if !all && !strings.HasPrefix(item.GetName(), prefix) {
+			continue
+		}
+		row := buildSummaryRow(item, debug)
+		if !filters.matches(row.PodName, row.PodNamespace, row.Volume) {
+			continue
+		}
+
+		keyParts := make([]string, len(groupBy))
+		for i, field := range groupBy {
+			keyParts[i] = summaryGroupFields[field](row)
+		}
+		keyStr := strings.Join(keyParts, "\x00")
+
+		agg, ok := byKey[keyStr]
+		if !ok {
+			agg = &summaryAggregate{Key: keyParts}
+			byKey[keyStr] = agg
+			order = append(order, keyStr)
+		}
+		agg.Count++
+		if row.SizeBytes != nil {
+			agg.TotalBytes += *row.SizeBytes
+		}
+		grandCount++
+		if row.SizeBytes != nil {
+			grandBytes += *row.SizeBytes
+		}
+	}
+
+	sort.Strings(order)
+	aggregates := make([]summaryAggregate, 0, len(order))
+	for _, k := range order {
+		agg := byKey[k]
+		agg.TotalHuman = humanBytes(agg.TotalBytes)
+		aggregates = append(aggregates, *agg)
+	}
+
+	return aggregates, grandCount, grandBytes, nil
+}
+
+func writeSummary(w io.Writer, groupBy []string, aggregates []summaryAggregate, grandCount int, grandBytes int64, outMode string) error {
+	switch outMode {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			GroupBy    []string           `json:"groupBy"`
+			Groups     []summaryAggregate `json:"groups"`
+			GrandTotal summaryAggregate   `json:"grandTotal"`
+		}{
+			GroupBy:    groupBy,
+			Groups:     aggregates,
+			GrandTotal: summaryAggregate{Count: grandCount, TotalBytes: grandBytes, TotalHuman: humanBytes(grandBytes)},
+		})
+	case "yaml":
+		b, err := yaml.Marshal(struct {
+			GroupBy    []string           `yaml:"groupBy"`
+			Groups     []summaryAggregate `yaml:"groups"`
+			GrandTotal summaryAggregate   `yaml:"grandTotal"`
+		}{
+			GroupBy:    groupBy,
+			Groups:     aggregates,
+			GrandTotal: summaryAggregate{Count: grandCount, TotalBytes: grandBytes, TotalHuman: humanBytes(grandBytes)},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode YAML: %w", err)
+		}
+		_, err = w.Write(b)
+		return err
+	case "csv":
+		cw := csv.NewWriter(w)
+		_ = cw.Write(append(append([]string{}, groupBy...), "Count", "TotalBytes", "TotalHuman"))
+		for _, a := range aggregates {
+			_ = cw.Write(append(append([]string{}, a.Key...), fmt.Sprintf("%d", a.Count), fmt.Sprintf("%d", a.TotalBytes), a.TotalHuman))
+		}
+		totalKey := make([]string, len(groupBy))
+		if len(totalKey) > 0 {
+			totalKey[0] = "TOTAL"
+		}
+		_ = cw.Write(append(totalKey, fmt.Sprintf("%d", grandCount), fmt.Sprintf("%d", grandBytes), humanBytes(grandBytes)))
+		cw.Flush()
+		return cw.Error()
+	case "pretty":
+		for _, a := range aggregates {
+			fmt.Fprintf(w, "%s  count=%d  bytes=%d (%s)\n", strings.Join(a.Key, "/"), a.Count, a.TotalBytes, a.TotalHuman)
+		}
+		fmt.Fprintf(w, "TOTAL  count=%d  bytes=%d (%s)\n", grandCount, grandBytes, humanBytes(grandBytes))
+		return nil
+	default: // table
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(groupBy, "\t")+"\tCount\tTotal bytes\tTotal")
+		for _, a := range aggregates {
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%s\n", strings.Join(a.Key, "\t"), a.Count, a.TotalBytes, a.TotalHuman)
+		}
+		fmt.Fprintf(tw, "%sTOTAL\t%d\t%d\t%s\n", strings.Repeat("\t", len(groupBy)-1), grandCount, grandBytes, humanBytes(grandBytes))
+		return tw.Flush()
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func pvbObject(name, backupName, podName, podNS, volume string, totalBytes int64) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   name,
+			"labels": map[string]interface{}{"velero.io/backup-name": backupName},
+		},
+		"spec": map[string]interface{}{
+			"pod":    map[string]interface{}{"name": podName, "namespace": podNS},
+			"volume": volume,
+		},
+		"status": map[string]interface{}{
+			"phase":    "Completed",
+			"progress": map[string]interface{}{"totalBytes": totalBytes, "bytesDone": totalBytes},
+		},
+	}}
+}
+
+func TestAggregateSummaryGroupsByBackup(t *testing.T) {
+	items := []unstructured.Unstructured{
+		pvbObject("pvb-1", "backup-a", "pod-1", "ns1", "vol1", 100),
+		pvbObject("pvb-2", "backup-a", "pod-2", "ns1", "vol2", 200),
+		pvbObject("pvb-3", "backup-b", "pod-3", "ns2", "vol1", 50),
+	}
+
+	aggregates, grandCount, grandBytes, err := aggregateSummary(items, true, "", pvbFilters{}, []string{"backup"}, false)
+	if err != nil {
+		t.Fatalf("aggregateSummary: %v", err)
+	}
+	if grandCount != 3 {
+		t.Errorf("expected grand total count of 3, got %d", grandCount)
+	}
+	if grandBytes != 350 {
+		t.Errorf("expected grand total bytes of 350, got %d", grandBytes)
+	}
+	if len(aggregates) != 2 {
+		t.Fatalf("expected 2 groups, got %d: %+v", len(aggregates), aggregates)
+	}
+
+	var a *summaryAggregate
+	for i := range aggregates {
+		if aggregates[i].Key[0] == "backup-a" {
+			a = &aggregates[i]
+		}
+	}
+	if a == nil {
+		t.Fatalf("expected a backup-a group, got %+v", aggregates)
+	}
+	if a.Count != 2 {
+		t.Errorf("expected backup-a group count of 2, got %d", a.Count)
+	}
+	if a.TotalBytes != 300 {
+		t.Errorf("expected backup-a group totalBytes of 300, got %d", a.TotalBytes)
+	}
+}
+
+func TestAggregateSummaryRejectsUnknownGroupBy(t *testing.T) {
+	_, _, _, err := aggregateSummary(nil, true, "", pvbFilters{}, []string{"bogus"}, false)
+	if err == nil {
+		t.Fatal("expected an error for an unknown --group-by key")
+	}
+}
+
+func TestWriteSummaryCSVIncludesGrandCount(t *testing.T) {
+	aggregates := []summaryAggregate{
+		{Key: []string{"backup-a"}, Count: 2, TotalBytes: 300, TotalHuman: humanBytes(300)},
+	}
+
+	var buf bytes.Buffer
+	if err := writeSummary(&buf, []string{"backup"}, aggregates, 2, 300, "csv"); err != nil {
+		t.Fatalf("writeSummary: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header + 1 group row + total row, got %d lines: %q", len(lines), lines)
+	}
+	total := strings.Split(lines[2], ",")
+	if total[0] != "TOTAL" {
+		t.Errorf("expected TOTAL label in first key column, got %q", total[0])
+	}
+	if total[1] != "2" {
+		t.Errorf("expected grandCount 2 in Count column, got %q", total[1])
+	}
+	if total[2] != "300" {
+		t.Errorf("expected grandBytes 300 in TotalBytes column, got %q", total[2])
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+// watchEvent is the envelope emitted for -o json|yaml in --watch mode.
+type watchEvent struct {
+	Type   string `json:"type" yaml:"type"`
+	Object Row    `json:"object" yaml:"object"`
+}
+
+// runWatch replaces the one-shot List with a dynamic informer that streams
+// ADDED/MODIFIED/DELETED rows as PodVolumeBackups progress. The informer's
+// reflector already resumes from the last known resourceVersion and relists
+// on a 410 Gone, with backoff between retries, so we only need to run it
+// until the process is asked to stop.
+func runWatch(client dynamic.Interface, namespace string, filters pvbFilters, all bool, prefix string, outMode string, colorEnabled bool, debug bool) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		cancel()
+	}()
+
+	screen := newScreenRenderer(outMode, colorEnabled)
+
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(client, 0, namespace, nil)
+	informer := factory.ForResource(podVolumeBackupGVR).Informer()
+
+	onEvent := func(eventType string) func(obj interface{}) {
+		return func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			item, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				return
+			}
+			if !all && !strings.HasPrefix(item.GetName(), prefix) {
+				return
+			}
+			row := rowFromUnstructured(*item, debug)
+			if !filters.matches(row.PodName, row.PodNamespace, row.Volume) {
+				return
+			}
+			screen.emit(eventType, row)
+		}
+	}
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: onEvent("ADDED"),
+		UpdateFunc: func(_, newObj interface{}) {
+			onEvent("MODIFIED")(newObj)
+		},
+		DeleteFunc: onEvent("DELETED"),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to register watch handler: %v\n", err)
+		os.Exit(1)
+	}
+
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+	<-ctx.Done()
+}
+
+// screenRenderer prints watch events as {type, object} envelopes: one JSON
+// object per line for json, one "---"-separated document per event for yaml.
+// For table/pretty it
+// reprints a row in place, tracking each resource's screen line by UID (here
+// the PVB resource name, which is unique per namespace).
+type screenRenderer struct {
+	mode         string
+	colorEnabled bool
+
+	mu    sync.Mutex
+	line  map[string]int
+	order []string
+}
+
+func newScreenRenderer(mode string, colorEnabled bool) *screenRenderer {
+	return &screenRenderer{mode: mode, colorEnabled: colorEnabled, line: map[string]int{}}
+}
+
+func (r *screenRenderer) emit(eventType string, row Row) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch r.mode {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		_ = enc.Encode(watchEvent{Type: eventType, Object: row})
+	case "yaml":
+		b, err := yaml.Marshal(watchEvent{Type: eventType, Object: row})
+		if err == nil {
+			fmt.Printf("---\n%s", b)
+		}
+	default:
+		r.emitInPlace(eventType, row)
+	}
+}
+
+func (r *screenRenderer) emitInPlace(eventType string, row Row) {
+	idx, isNew := r.lineFor(row.ResourceName)
+	text := formatWatchLine(eventType, row, r.colorEnabled)
+	if isNew {
+		fmt.Println(text)
+		return
+	}
+	up := len(r.order) - idx
+	fmt.Printf("\x1b[%dA\r\x1b[2K%s\x1b[%dB\r", up, text, up)
+}
+
+func (r *screenRenderer) lineFor(resourceName string) (idx int, isNew bool) {
+	if i, ok := r.line[resourceName]; ok {
+		return i, false
+	}
+	idx = len(r.order)
+	r.line[resourceName] = idx
+	r.order = append(r.order, resourceName)
+	return idx, true
+}
+
+func formatWatchLine(eventType string, row Row, colorEnabled bool) string {
+	typeColor := map[string]string{"ADDED": "32", "MODIFIED": "33", "DELETED": "31"}[eventType]
+	sizeOut := "-"
+	if row.SizeBytes != nil {
+		sizeOut = row.SizeHuman
+	}
+	return fmt.Sprintf("%s %s/%s volume=%s size=%s backup=%s",
+		c(colorEnabled, typeColor, fmt.Sprintf("[%-8s]", eventType)),
+		row.PodNamespace, row.PodName, row.Volume, sizeOut, row.BackupName)
+}